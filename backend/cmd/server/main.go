@@ -100,7 +100,10 @@ func runSetupServer() {
 	r := gin.New()
 	r.Use(middleware.Recovery())
 	r.Use(middleware.CORS(config.CORSConfig{}))
-	r.Use(middleware.SecurityHeaders(config.CSPConfig{Enabled: true, Policy: config.DefaultCSPPolicy}, nil))
+	r.Use(middleware.SecurityHeaders(config.SecurityConfig{
+		CSP:               config.CSPConfig{Enabled: true, Policy: config.DefaultCSPPolicy},
+		PermissionsPolicy: config.PermissionsPolicyConfig{Enabled: true, Policy: config.DefaultPermissionsPolicy},
+	}, nil))
 
 	// Register setup routes
 	setup.RegisterRoutes(r)
@@ -30,6 +30,9 @@ const (
 // __CSP_NONCE__ will be replaced with actual nonce at request time by the SecurityHeaders middleware
 const DefaultCSPPolicy = "default-src 'self'; script-src 'self' __CSP_NONCE__ https://challenges.cloudflare.com https://static.cloudflareinsights.com; style-src 'self' 'unsafe-inline' https://fonts.googleapis.com; img-src 'self' data: https:; font-src 'self' data: https://fonts.gstatic.com; connect-src 'self' https:; frame-src https://challenges.cloudflare.com; frame-ancestors 'none'; base-uri 'self'; form-action 'self'"
 
+// DefaultPermissionsPolicy disables powerful browser features the admin dashboard never uses
+const DefaultPermissionsPolicy = "accelerometer=(), camera=(), geolocation=(), gyroscope=(), magnetometer=(), microphone=(), payment=(), usb=()"
+
 // UMQ（用户消息队列）模式常量
 const (
 	// UMQModeSerialize: 账号级串行锁 + RPM 自适应延迟
@@ -241,11 +244,12 @@ type CORSConfig struct {
 }
 
 type SecurityConfig struct {
-	URLAllowlist    URLAllowlistConfig   `mapstructure:"url_allowlist"`
-	ResponseHeaders ResponseHeaderConfig `mapstructure:"response_headers"`
-	CSP             CSPConfig            `mapstructure:"csp"`
-	ProxyFallback   ProxyFallbackConfig  `mapstructure:"proxy_fallback"`
-	ProxyProbe      ProxyProbeConfig     `mapstructure:"proxy_probe"`
+	URLAllowlist      URLAllowlistConfig      `mapstructure:"url_allowlist"`
+	ResponseHeaders   ResponseHeaderConfig    `mapstructure:"response_headers"`
+	CSP               CSPConfig               `mapstructure:"csp"`
+	PermissionsPolicy PermissionsPolicyConfig `mapstructure:"permissions_policy"`
+	ProxyFallback     ProxyFallbackConfig     `mapstructure:"proxy_fallback"`
+	ProxyProbe        ProxyProbeConfig        `mapstructure:"proxy_probe"`
 }
 
 type URLAllowlistConfig struct {
@@ -267,6 +271,24 @@ type ResponseHeaderConfig struct {
 type CSPConfig struct {
 	Enabled bool   `mapstructure:"enabled"`
 	Policy  string `mapstructure:"policy"`
+	// ReportOnly 为 true 时改为下发 Content-Security-Policy-Report-Only，不拦截违规。
+	// 收集报告需在策略中自行包含 report-uri/report-to，否则违规仅输出到浏览器控制台。
+	ReportOnly bool `mapstructure:"report_only"`
+	// RoutePolicies 按请求路径前缀覆盖 CSP 策略（最长前缀优先），未命中时使用 Policy。
+	// 覆盖策略按原样下发，不会自动补充 nonce、Cloudflare Insights 与动态 frame-src。
+	RoutePolicies []CSPRoutePolicy `mapstructure:"route_policies"`
+}
+
+// CSPRoutePolicy 是绑定到请求路径前缀的 CSP 覆盖策略。
+// 前缀放在值中而非 map 键中，避免 viper 按 "." 拆分键名并将其转为小写。
+type CSPRoutePolicy struct {
+	Prefix string `mapstructure:"prefix"`
+	Policy string `mapstructure:"policy"`
+}
+
+type PermissionsPolicyConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Policy  string `mapstructure:"policy"`
 }
 
 type ProxyFallbackConfig struct {
@@ -977,6 +999,8 @@ func load(allowMissingJWTSecret bool) (*Config, error) {
 	cfg.Security.ResponseHeaders.AdditionalAllowed = normalizeStringSlice(cfg.Security.ResponseHeaders.AdditionalAllowed)
 	cfg.Security.ResponseHeaders.ForceRemove = normalizeStringSlice(cfg.Security.ResponseHeaders.ForceRemove)
 	cfg.Security.CSP.Policy = strings.TrimSpace(cfg.Security.CSP.Policy)
+	cfg.Security.CSP.RoutePolicies = normalizeCSPRoutePolicies(cfg.Security.CSP.RoutePolicies)
+	cfg.Security.PermissionsPolicy.Policy = strings.TrimSpace(cfg.Security.PermissionsPolicy.Policy)
 	cfg.Log.Level = strings.ToLower(strings.TrimSpace(cfg.Log.Level))
 	cfg.Log.Format = strings.ToLower(strings.TrimSpace(cfg.Log.Format))
 	cfg.Log.ServiceName = strings.TrimSpace(cfg.Log.ServiceName)
@@ -1112,6 +1136,9 @@ func setDefaults() {
 	viper.SetDefault("security.response_headers.force_remove", []string{})
 	viper.SetDefault("security.csp.enabled", true)
 	viper.SetDefault("security.csp.policy", DefaultCSPPolicy)
+	viper.SetDefault("security.csp.report_only", false)
+	viper.SetDefault("security.permissions_policy.enabled", true)
+	viper.SetDefault("security.permissions_policy.policy", DefaultPermissionsPolicy)
 	viper.SetDefault("security.proxy_probe.insecure_skip_verify", false)
 
 	// Security - disable direct fallback on proxy error
@@ -1523,6 +1550,9 @@ func (c *Config) Validate() error {
 	if c.Security.CSP.Enabled && strings.TrimSpace(c.Security.CSP.Policy) == "" {
 		return fmt.Errorf("security.csp.policy is required when CSP is enabled")
 	}
+	if c.Security.PermissionsPolicy.Enabled && strings.TrimSpace(c.Security.PermissionsPolicy.Policy) == "" {
+		return fmt.Errorf("security.permissions_policy.policy is required when permissions policy is enabled")
+	}
 	if c.LinuxDo.Enabled {
 		if strings.TrimSpace(c.LinuxDo.ClientID) == "" {
 			return fmt.Errorf("linuxdo_connect.client_id is required when linuxdo_connect.enabled=true")
@@ -2081,6 +2111,22 @@ func normalizeStringSlice(values []string) []string {
 	return normalized
 }
 
+func normalizeCSPRoutePolicies(policies []CSPRoutePolicy) []CSPRoutePolicy {
+	if len(policies) == 0 {
+		return policies
+	}
+	normalized := make([]CSPRoutePolicy, 0, len(policies))
+	for _, rp := range policies {
+		prefix := strings.TrimSpace(rp.Prefix)
+		policy := strings.TrimSpace(rp.Policy)
+		if prefix == "" || policy == "" {
+			continue
+		}
+		normalized = append(normalized, CSPRoutePolicy{Prefix: prefix, Policy: policy})
+	}
+	return normalized
+}
+
 func isWeakJWTSecret(secret string) bool {
 	lower := strings.ToLower(strings.TrimSpace(secret))
 	if lower == "" {
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -912,6 +914,14 @@ func TestValidateConfigErrors(t *testing.T) {
 			mutate:  func(c *Config) { c.Security.CSP.Enabled = true; c.Security.CSP.Policy = "" },
 			wantErr: "security.csp.policy",
 		},
+		{
+			name: "permissions policy required",
+			mutate: func(c *Config) {
+				c.Security.PermissionsPolicy.Enabled = true
+				c.Security.PermissionsPolicy.Policy = ""
+			},
+			wantErr: "security.permissions_policy.policy",
+		},
 		{
 			name: "linuxdo client id required",
 			mutate: func(c *Config) {
@@ -1603,3 +1613,34 @@ func TestLoad_DefaultGatewayUsageRecordConfig(t *testing.T) {
 		t.Fatalf("auto_scale_cooldown_seconds = %d, want 10", cfg.Gateway.UsageRecord.AutoScaleCooldownSeconds)
 	}
 }
+
+func TestLoadCSPRoutePoliciesFromYAML(t *testing.T) {
+	resetViperWithJWTSecret(t)
+
+	dir := t.TempDir()
+	yaml := `security:
+  csp:
+    route_policies:
+      - prefix: " /Admin/v1.0/ "
+        policy: " default-src 'none' "
+      - prefix: "/blank/"
+        policy: "  "
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(yaml), 0o600))
+	t.Setenv("DATA_DIR", dir)
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	require.Equal(t, []CSPRoutePolicy{{Prefix: "/Admin/v1.0/", Policy: "default-src 'none'"}}, cfg.Security.CSP.RoutePolicies)
+}
+
+func TestNormalizeCSPRoutePolicies(t *testing.T) {
+	require.Nil(t, normalizeCSPRoutePolicies(nil))
+
+	got := normalizeCSPRoutePolicies([]CSPRoutePolicy{
+		{Prefix: " /api/ ", Policy: " default-src 'none' "},
+		{Prefix: " ", Policy: "default-src 'self'"},
+		{Prefix: "/setup", Policy: ""},
+	})
+	require.Equal(t, []CSPRoutePolicy{{Prefix: "/api/", Policy: "default-src 'none'"}}, got)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
@@ -41,20 +42,39 @@ func GetNonceFromContext(c *gin.Context) string {
 }
 
 // SecurityHeaders sets baseline security headers for all responses.
+// CSP and Permissions-Policy are taken from the corresponding sections of cfg.
 // getFrameSrcOrigins is an optional function that returns extra origins to inject into frame-src;
 // pass nil to disable dynamic frame-src injection.
-func SecurityHeaders(cfg config.CSPConfig, getFrameSrcOrigins func() []string) gin.HandlerFunc {
+func SecurityHeaders(securityCfg config.SecurityConfig, getFrameSrcOrigins func() []string) gin.HandlerFunc {
+	cfg := securityCfg.CSP
+	permissionsPolicy := securityCfg.PermissionsPolicy
+
 	policy := strings.TrimSpace(cfg.Policy)
 	if policy == "" {
 		policy = config.DefaultCSPPolicy
 	}
 
+	featurePolicy := ""
+	if permissionsPolicy.Enabled {
+		featurePolicy = strings.TrimSpace(permissionsPolicy.Policy)
+		if featurePolicy == "" {
+			featurePolicy = config.DefaultPermissionsPolicy
+		}
+	}
+
 	// Enhance policy with required directives (nonce placeholder and Cloudflare Insights)
 	policy = enhanceCSPPolicy(policy)
+	routePolicies := buildCSPRoutePolicies(cfg.RoutePolicies)
+
+	cspHeader := "Content-Security-Policy"
+	if cfg.ReportOnly {
+		cspHeader = "Content-Security-Policy-Report-Only"
+	}
 
 	return func(c *gin.Context) {
-		finalPolicy := policy
-		if getFrameSrcOrigins != nil {
+		finalPolicy, overridden := selectCSPPolicy(c, routePolicies, policy)
+		// Route overrides are sent as configured; only the global policy gets dynamic frame-src origins.
+		if getFrameSrcOrigins != nil && !overridden {
 			for _, origin := range getFrameSrcOrigins() {
 				if origin != "" {
 					finalPolicy = addToDirective(finalPolicy, "frame-src", origin)
@@ -65,6 +85,9 @@ func SecurityHeaders(cfg config.CSPConfig, getFrameSrcOrigins func() []string) g
 		c.Header("X-Content-Type-Options", "nosniff")
 		c.Header("X-Frame-Options", "DENY")
 		c.Header("Referrer-Policy", "strict-origin-when-cross-origin")
+		if featurePolicy != "" {
+			c.Header("Permissions-Policy", featurePolicy)
+		}
 		if isAPIRoutePath(c) {
 			c.Next()
 			return
@@ -76,21 +99,61 @@ func SecurityHeaders(cfg config.CSPConfig, getFrameSrcOrigins func() []string) g
 			if err != nil {
 				// crypto/rand 失败时降级为无 nonce 的 CSP 策略
 				log.Printf("[SecurityHeaders] %v — 降级为无 nonce 的 CSP", err)
-				c.Header("Content-Security-Policy", strings.ReplaceAll(finalPolicy, NonceTemplate, "'unsafe-inline'"))
+				c.Header(cspHeader, strings.ReplaceAll(finalPolicy, NonceTemplate, "'unsafe-inline'"))
 			} else {
 				c.Set(CSPNonceKey, nonce)
-				c.Header("Content-Security-Policy", strings.ReplaceAll(finalPolicy, NonceTemplate, "'nonce-"+nonce+"'"))
+				c.Header(cspHeader, strings.ReplaceAll(finalPolicy, NonceTemplate, "'nonce-"+nonce+"'"))
 			}
 		}
 		c.Next()
 	}
 }
 
+// buildCSPRoutePolicies returns the (already normalized) route overrides sorted by
+// descending prefix length so the most specific prefix is matched first.
+// Overrides under API route prefixes never apply because CSP is skipped there, so they
+// are dropped with a warning.
+func buildCSPRoutePolicies(policies []config.CSPRoutePolicy) []config.CSPRoutePolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+	result := make([]config.CSPRoutePolicy, 0, len(policies))
+	for _, rp := range policies {
+		if isAPIPath(rp.Prefix) {
+			log.Printf("[SecurityHeaders] CSP route policy for %q ignored: API routes do not send CSP", rp.Prefix)
+			continue
+		}
+		result = append(result, rp)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return len(result[i].Prefix) > len(result[j].Prefix)
+	})
+	return result
+}
+
+// selectCSPPolicy returns the policy of the longest matching route prefix and true,
+// or the global policy and false when no override matches.
+func selectCSPPolicy(c *gin.Context, routePolicies []config.CSPRoutePolicy, fallback string) (string, bool) {
+	if len(routePolicies) == 0 || c == nil || c.Request == nil || c.Request.URL == nil {
+		return fallback, false
+	}
+	path := c.Request.URL.Path
+	for _, rp := range routePolicies {
+		if strings.HasPrefix(path, rp.Prefix) {
+			return rp.Policy, true
+		}
+	}
+	return fallback, false
+}
+
 func isAPIRoutePath(c *gin.Context) bool {
 	if c == nil || c.Request == nil || c.Request.URL == nil {
 		return false
 	}
-	path := c.Request.URL.Path
+	return isAPIPath(c.Request.URL.Path)
+}
+
+func isAPIPath(path string) bool {
 	return strings.HasPrefix(path, "/v1/") ||
 		strings.HasPrefix(path, "/v1beta/") ||
 		strings.HasPrefix(path, "/antigravity/") ||
@@ -84,7 +84,7 @@ func TestGetNonceFromContext(t *testing.T) {
 func TestSecurityHeaders(t *testing.T) {
 	t.Run("sets_basic_security_headers", func(t *testing.T) {
 		cfg := config.CSPConfig{Enabled: false}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -97,9 +97,48 @@ func TestSecurityHeaders(t *testing.T) {
 		assert.Equal(t, "strict-origin-when-cross-origin", w.Header().Get("Referrer-Policy"))
 	})
 
+	t.Run("permissions_policy_disabled_no_header", func(t *testing.T) {
+		cfg := config.CSPConfig{Enabled: false}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg, PermissionsPolicy: config.PermissionsPolicyConfig{Enabled: false}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+		middleware(c)
+
+		assert.Empty(t, w.Header().Get("Permissions-Policy"))
+	})
+
+	t.Run("permissions_policy_uses_default_when_empty", func(t *testing.T) {
+		cfg := config.CSPConfig{Enabled: false}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg, PermissionsPolicy: config.PermissionsPolicyConfig{Enabled: true, Policy: "  "}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+		middleware(c)
+
+		assert.Equal(t, config.DefaultPermissionsPolicy, w.Header().Get("Permissions-Policy"))
+	})
+
+	t.Run("permissions_policy_custom_value", func(t *testing.T) {
+		cfg := config.CSPConfig{Enabled: false}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg, PermissionsPolicy: config.PermissionsPolicyConfig{Enabled: true, Policy: "camera=(self)"}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+
+		middleware(c)
+
+		assert.Equal(t, "camera=(self)", w.Header().Get("Permissions-Policy"))
+	})
+
 	t.Run("csp_disabled_no_csp_header", func(t *testing.T) {
 		cfg := config.CSPConfig{Enabled: false}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -115,7 +154,7 @@ func TestSecurityHeaders(t *testing.T) {
 			Enabled: true,
 			Policy:  "default-src 'self'",
 		}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -131,12 +170,48 @@ func TestSecurityHeaders(t *testing.T) {
 		assert.Contains(t, csp, CloudflareInsightsDomain)
 	})
 
+	t.Run("report_only_uses_report_only_header", func(t *testing.T) {
+		cfg := config.CSPConfig{
+			Enabled:    true,
+			ReportOnly: true,
+			Policy:     "default-src 'self'; script-src 'self' __CSP_NONCE__",
+		}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+		middleware(c)
+
+		assert.Empty(t, w.Header().Get("Content-Security-Policy"))
+		csp := w.Header().Get("Content-Security-Policy-Report-Only")
+		nonce := GetNonceFromContext(c)
+		require.NotEmpty(t, nonce)
+		assert.Contains(t, csp, "'nonce-"+nonce+"'")
+		assert.NotContains(t, csp, NonceTemplate)
+	})
+
+	t.Run("report_only_ignored_when_disabled", func(t *testing.T) {
+		cfg := config.CSPConfig{Enabled: false, ReportOnly: true, Policy: "default-src 'self'"}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+		middleware(c)
+
+		assert.Empty(t, w.Header().Get("Content-Security-Policy"))
+		assert.Empty(t, w.Header().Get("Content-Security-Policy-Report-Only"))
+	})
+
 	t.Run("api_route_skips_csp_nonce_generation", func(t *testing.T) {
 		cfg := config.CSPConfig{
 			Enabled: true,
 			Policy:  "default-src 'self'; script-src 'self' __CSP_NONCE__",
 		}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -156,7 +231,7 @@ func TestSecurityHeaders(t *testing.T) {
 			Enabled: true,
 			Policy:  "script-src 'self' __CSP_NONCE__",
 		}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -180,7 +255,7 @@ func TestSecurityHeaders(t *testing.T) {
 			Enabled: true,
 			Policy:  "",
 		}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -199,7 +274,7 @@ func TestSecurityHeaders(t *testing.T) {
 			Enabled: true,
 			Policy:  "   \t\n  ",
 		}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -217,7 +292,7 @@ func TestSecurityHeaders(t *testing.T) {
 			Enabled: true,
 			Policy:  "script-src __CSP_NONCE__; style-src __CSP_NONCE__",
 		}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -233,9 +308,80 @@ func TestSecurityHeaders(t *testing.T) {
 		assert.Equal(t, 2, count, "both placeholders should be replaced with same nonce")
 	})
 
+	t.Run("route_policy_overrides_global_policy", func(t *testing.T) {
+		cfg := config.CSPConfig{
+			Enabled: true,
+			Policy:  "default-src 'self'",
+			RoutePolicies: []config.CSPRoutePolicy{
+				{Prefix: "/api/", Policy: "default-src 'none'; frame-ancestors 'none'"},
+				{Prefix: "/api/admin/", Policy: "default-src 'none'; script-src __CSP_NONCE__"},
+			},
+		}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/auth/me", nil)
+		middleware(c)
+
+		assert.Equal(t, "default-src 'none'; frame-ancestors 'none'", w.Header().Get("Content-Security-Policy"))
+
+		w = httptest.NewRecorder()
+		c, _ = gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/admin/users", nil)
+		middleware(c)
+
+		nonce := GetNonceFromContext(c)
+		require.NotEmpty(t, nonce)
+		assert.Equal(t, "default-src 'none'; script-src 'nonce-"+nonce+"'", w.Header().Get("Content-Security-Policy"))
+	})
+
+	t.Run("route_policy_falls_back_to_global_policy", func(t *testing.T) {
+		cfg := config.CSPConfig{
+			Enabled:       true,
+			Policy:        "default-src 'self'",
+			RoutePolicies: []config.CSPRoutePolicy{{Prefix: "/api/", Policy: "default-src 'none'"}},
+		}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/dashboard", nil)
+		middleware(c)
+
+		csp := w.Header().Get("Content-Security-Policy")
+		assert.Contains(t, csp, "default-src 'self'")
+		assert.Contains(t, csp, "'nonce-"+GetNonceFromContext(c)+"'")
+	})
+
+	t.Run("route_policy_skips_frame_src_injection", func(t *testing.T) {
+		cfg := config.CSPConfig{
+			Enabled:       true,
+			Policy:        "default-src 'self'",
+			RoutePolicies: []config.CSPRoutePolicy{{Prefix: "/api/", Policy: "default-src 'none'; frame-ancestors 'none'"}},
+		}
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, func() []string {
+			return []string{"https://pay.example.com"}
+		})
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/settings", nil)
+		middleware(c)
+
+		assert.Equal(t, "default-src 'none'; frame-ancestors 'none'", w.Header().Get("Content-Security-Policy"))
+
+		w = httptest.NewRecorder()
+		c, _ = gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/dashboard", nil)
+		middleware(c)
+
+		assert.Contains(t, w.Header().Get("Content-Security-Policy"), "https://pay.example.com")
+	})
+
 	t.Run("calls_next_handler", func(t *testing.T) {
 		cfg := config.CSPConfig{Enabled: true, Policy: "default-src 'self'"}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		nextCalled := false
 		router := gin.New()
@@ -258,7 +404,7 @@ func TestSecurityHeaders(t *testing.T) {
 			Enabled: true,
 			Policy:  "script-src __CSP_NONCE__",
 		}
-		middleware := SecurityHeaders(cfg, nil)
+		middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 		nonces := make(map[string]bool)
 		for i := 0; i < 10; i++ {
@@ -364,6 +510,36 @@ func TestAddToDirective(t *testing.T) {
 	})
 }
 
+func TestBuildCSPRoutePolicies(t *testing.T) {
+	t.Run("sorts_by_prefix_length_desc", func(t *testing.T) {
+		result := buildCSPRoutePolicies([]config.CSPRoutePolicy{
+			{Prefix: "/api/", Policy: "a"},
+			{Prefix: "/api/admin/", Policy: "b"},
+			{Prefix: "/", Policy: "c"},
+		})
+
+		require.Len(t, result, 3)
+		assert.Equal(t, "/api/admin/", result[0].Prefix)
+		assert.Equal(t, "/api/", result[1].Prefix)
+		assert.Equal(t, "/", result[2].Prefix)
+	})
+
+	t.Run("drops_api_route_prefixes", func(t *testing.T) {
+		result := buildCSPRoutePolicies([]config.CSPRoutePolicy{
+			{Prefix: "/v1/", Policy: "default-src 'none'"},
+			{Prefix: "/responses", Policy: "default-src 'none'"},
+			{Prefix: "/setup", Policy: "default-src 'self'"},
+		})
+
+		require.Len(t, result, 1)
+		assert.Equal(t, "/setup", result[0].Prefix)
+	})
+
+	t.Run("nil_for_empty_list", func(t *testing.T) {
+		assert.Nil(t, buildCSPRoutePolicies(nil))
+	})
+}
+
 // Benchmark tests
 func BenchmarkGenerateNonce(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -376,7 +552,7 @@ func BenchmarkSecurityHeadersMiddleware(b *testing.B) {
 		Enabled: true,
 		Policy:  "script-src 'self' __CSP_NONCE__",
 	}
-	middleware := SecurityHeaders(cfg, nil)
+	middleware := SecurityHeaders(config.SecurityConfig{CSP: cfg}, nil)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
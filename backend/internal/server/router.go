@@ -54,7 +54,7 @@ func SetupRouter(
 	r.Use(middleware2.RequestLogger())
 	r.Use(middleware2.Logger())
 	r.Use(middleware2.CORS(cfg.CORS))
-	r.Use(middleware2.SecurityHeaders(cfg.Security.CSP, func() []string {
+	r.Use(middleware2.SecurityHeaders(cfg.Security, func() []string {
 		if p := cachedFrameOrigins.Load(); p != nil {
 			return *p
 		}